@@ -0,0 +1,228 @@
+// Command serve wires together the database, repository, service and
+// handler layers and runs the HTTP server.
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+
+	// ต้องสร้างไฟล์ docs/docs.go และรัน swaggo init ก่อนจึงจะใช้ได้
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"week11-lab1/internal/auth"
+	"week11-lab1/internal/handlers"
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/query"
+	"week11-lab1/internal/repository"
+	"week11-lab1/internal/service"
+)
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// newAuthenticator picks the admin Authenticator from the environment: a
+// Tailscale WhoIs check if ADMIN_TAILNET_TAGS lists allowed tags, otherwise
+// a static bearer token from ADMIN_TOKEN.
+func newAuthenticator() auth.Authenticator {
+	if tags := getEnv("ADMIN_TAILNET_TAGS", ""); tags != "" {
+		return auth.NewTailscaleAuthenticator(strings.Split(tags, ","))
+	}
+	return auth.NewStaticTokenAuthenticator(getEnv("ADMIN_TOKEN", ""))
+}
+
+func initDB() *sql.DB {
+	host := getEnv("DB_HOST", "localhost")
+	name := getEnv("DB_NAME", "bookstore")
+	user := getEnv("DB_USER", "bookstore_user")
+	password := getEnv("DB_PASSWORD", "your_strong_password")
+	port := getEnv("DB_PORT", "5432")
+
+	conSt := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", host, port, user, password, name)
+	db, err := sql.Open("postgres", conSt)
+	if err != nil {
+		log.Fatal("failed to open database:", err)
+	}
+
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(20)
+	db.SetConnMaxLifetime(5 * time.Minute)
+
+	if err := db.Ping(); err != nil {
+		log.Fatal("failed to connect to database:", err)
+	}
+
+	log.Println("successfully connect to database")
+	return db
+}
+
+// seedBooks clears the books table and inserts a small set of example
+// rows, so a fresh database has something to browse. Category names are
+// resolved to top-level category ids, creating them if they don't exist.
+func seedBooks(repo repository.BookRepository, categories repository.CategoryRepository) {
+	originalPrice1 := 990.00
+	pages1 := 500
+
+	originalPrice2 := 1600.00
+	pages2 := 800
+
+	originalPrice3 := 1500.75
+	pages3 := 650
+
+	programmingID := mustCategoryID(categories, "Programming")
+	softwareDesignID := mustCategoryID(categories, "Software Design")
+	databaseID := mustCategoryID(categories, "Database")
+
+	books := []models.Book{
+		{
+			Title: "The Go Programming Language", Author: "Alan A. A. Donovan", ISBN: "978-0134190440", Year: 2015, Price: 890.50,
+			CategoryID: programmingID, OriginalPrice: nullFloat(originalPrice1), Discount: 10, CoverImage: "go.jpg",
+			Rating: 4.8, ReviewsCount: 150, IsNew: false, Pages: nullInt(pages1), Language: "English",
+			Publisher: "Addison-Wesley Professional", Description: "A comprehensive guide to the Go language.",
+		},
+		{
+			Title: "Clean Architecture", Author: "Robert C. Martin", ISBN: "978-0134494166", Year: 2017, Price: 1250.00,
+			CategoryID: softwareDesignID, OriginalPrice: nullFloat(originalPrice2), Discount: 21, CoverImage: "clean.jpg",
+			Rating: 4.5, ReviewsCount: 90, IsNew: true, Pages: nullInt(pages2), Language: "English",
+			Publisher: "Prentice Hall", Description: "A blueprint for software structure.",
+		},
+		{
+			Title: "Designing Data-Intensive Applications", Author: "Martin Kleppmann", ISBN: "978-1449373320", Year: 2017, Price: 1500.75,
+			CategoryID: databaseID, OriginalPrice: nullFloat(originalPrice3), Discount: 0, CoverImage: "data.jpg",
+			Rating: 4.9, ReviewsCount: 200, IsNew: false, Pages: nullInt(pages3), Language: "English",
+			Publisher: "O'Reilly Media", Description: "The essential guide to the fundamentals of systems.",
+		},
+	}
+
+	if err := repo.Seed(books); err != nil {
+		log.Fatalf("Failed to seed books: %v", err)
+	}
+	log.Println("Database seeded with initial data successfully!")
+}
+
+func mustCategoryID(categories repository.CategoryRepository, name string) int {
+	id, err := categories.GetOrCreateByName(name)
+	if err != nil {
+		log.Fatalf("Failed to resolve category %q: %v", name, err)
+	}
+	return id
+}
+
+func nullFloat(v float64) models.NullFloat64 {
+	return models.NullFloat64{NullFloat64: sql.NullFloat64{Float64: v, Valid: true}}
+}
+
+func nullInt(v int) models.NullInt64 {
+	return models.NullInt64{NullInt64: sql.NullInt64{Int64: int64(v), Valid: true}}
+}
+
+// @title           Bookstore API Example (Extended)
+// @version         1.0
+// @description     This is an extended API for managing books with rich data.
+// @host            localhost:8080
+// @BasePath        /api/v1
+func main() {
+	recomputeAggregates := flag.Bool("recompute-aggregates", false, "recompute every book's rating/reviews_count from its reviews, then exit")
+	seed := flag.Bool("seed", false, "clear the books table and seed it with example data, then continue starting the server")
+	flag.Parse()
+
+	db := initDB()
+	defer db.Close()
+
+	bookRepo, err := repository.NewPostgresBookRepository(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	reviewRepo, err := repository.NewPostgresReviewRepository(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	categoryRepo, err := repository.NewPostgresCategoryRepository(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	reviewService := service.NewReviewService(reviewRepo, bookRepo)
+	categoryService := service.NewCategoryService(categoryRepo)
+
+	if *recomputeAggregates {
+		if err := reviewService.RecomputeAllAggregates(); err != nil {
+			log.Fatalf("Failed to recompute aggregates: %v", err)
+		}
+		log.Println("Recomputed rating/reviews_count for all books.")
+		return
+	}
+
+	if *seed {
+		seedBooks(bookRepo, categoryRepo)
+	}
+
+	bookService := service.NewBookService(bookRepo, categoryRepo, query.NewRegistryFromEnv())
+	bookHandler := handlers.NewBookHandler(bookService)
+	reviewHandler := handlers.NewReviewHandler(reviewService)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+
+	r := gin.Default()
+	r.Use(cors.Default())
+
+	// Swagger docs route - รัน 'swag init' ก่อนรันโปรแกรม
+	r.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	// Health check
+	r.GET("/health", func(c *gin.Context) {
+		if err := db.Ping(); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"message": "unhealthy", "err": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "healthy"})
+	})
+
+	// Public API routes - read-only.
+	api := r.Group("/api/v1")
+	{
+		api.GET("/books", bookHandler.GetAllBooks)
+		api.GET("/books/:id", bookHandler.GetBook)
+
+		// Discovery/Search
+		api.GET("/categories", categoryHandler.GetCategories)
+		api.GET("/categories/:id/books", categoryHandler.GetCategoryBooks)
+		api.GET("/books/search", bookHandler.SearchBooks)
+		api.GET("/books/featured", bookHandler.GetFeaturedBooks)
+		api.GET("/books/new", bookHandler.GetNewBooks)
+		api.GET("/books/discounted", bookHandler.GetDiscountedBooks)
+
+		// Reviews
+		api.GET("/books/:id/reviews", reviewHandler.ListReviews)
+	}
+
+	// Admin API routes - everything that creates, updates or deletes data,
+	// gated behind auth.Middleware.
+	admin := r.Group("/api/v1/admin", auth.Middleware(newAuthenticator()))
+	{
+		admin.POST("/books", bookHandler.CreateBook)
+		admin.PUT("/books/:id", bookHandler.UpdateBook)
+		admin.DELETE("/books/:id", bookHandler.DeleteBook)
+		admin.POST("/books/import", bookHandler.ImportBook)
+		admin.GET("/books/:id/enrich", bookHandler.EnrichBook)
+
+		admin.POST("/books/:id/reviews", reviewHandler.CreateReview)
+		admin.DELETE("/reviews/:id", reviewHandler.DeleteReview)
+	}
+
+	// เริ่มต้น Server
+	log.Println("Server is running on http://localhost:8080")
+	r.Run(":8080")
+}
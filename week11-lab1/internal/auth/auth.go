@@ -0,0 +1,12 @@
+// Package auth authenticates admin requests via pluggable Authenticator
+// implementations, so the admin route group isn't tied to one scheme.
+package auth
+
+import "net/http"
+
+// Authenticator decides whether an admin request is allowed. identity is a
+// human-readable description of the caller (a node name, "static-token",
+// ...), used for logging; it is meaningless when ok is false.
+type Authenticator interface {
+	Authenticate(r *http.Request) (identity string, ok bool)
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware returns a gin middleware that rejects any request
+// Authenticator doesn't allow, with 401 Unauthorized.
+func Middleware(a Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, ok := a.Authenticate(c.Request)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+		c.Set("identity", identity)
+		c.Next()
+	}
+}
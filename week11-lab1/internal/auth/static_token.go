@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// StaticTokenAuthenticator allows requests carrying an
+// "Authorization: Bearer <token>" header matching Token, configured via
+// the ADMIN_TOKEN env var.
+type StaticTokenAuthenticator struct {
+	Token string
+}
+
+func NewStaticTokenAuthenticator(token string) *StaticTokenAuthenticator {
+	return &StaticTokenAuthenticator{Token: token}
+}
+
+func (a *StaticTokenAuthenticator) Authenticate(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" || a.Token == "" {
+		return "", false
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.Token)) != 1 {
+		return "", false
+	}
+	return "static-token", true
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"tailscale.com/client/tailscale"
+)
+
+// TailscaleAuthenticator allows requests only from tailnet nodes tagged
+// with one of AllowedTags, resolved via LocalClient.WhoIs against the
+// caller's remote address. This lets the admin API be exposed only to a
+// controlled tailnet without issuing separate credentials.
+type TailscaleAuthenticator struct {
+	Client      *tailscale.LocalClient
+	AllowedTags []string
+}
+
+func NewTailscaleAuthenticator(allowedTags []string) *TailscaleAuthenticator {
+	return &TailscaleAuthenticator{
+		Client:      &tailscale.LocalClient{},
+		AllowedTags: allowedTags,
+	}
+}
+
+func (a *TailscaleAuthenticator) Authenticate(r *http.Request) (string, bool) {
+	info, err := a.Client.WhoIs(r.Context(), r.RemoteAddr)
+	if err != nil || info == nil || info.Node == nil {
+		return "", false
+	}
+
+	for _, tag := range info.Node.Tags {
+		if a.tagAllowed(tag) {
+			return info.Node.Name, true
+		}
+	}
+	return "", false
+}
+
+func (a *TailscaleAuthenticator) tagAllowed(tag string) bool {
+	for _, allowed := range a.AllowedTags {
+		if strings.EqualFold(tag, allowed) {
+			return true
+		}
+	}
+	return false
+}
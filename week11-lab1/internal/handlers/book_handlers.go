@@ -0,0 +1,314 @@
+// Package handlers wires HTTP requests to the service layer. Handlers
+// hold no business logic of their own beyond request parsing and
+// response shaping.
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/query"
+	"week11-lab1/internal/repository"
+	"week11-lab1/internal/service"
+)
+
+// BookHandler serves the /books routes, depending on the service layer
+// via constructor injection so it can be tested against a BookService
+// built on a mock repository.
+type BookHandler struct {
+	service *service.BookService
+}
+
+func NewBookHandler(svc *service.BookService) *BookHandler {
+	return &BookHandler{service: svc}
+}
+
+// @Summary Get all books
+// @Description Retrieve books, with pagination, sorting and filters. Filters: category_id, author, publisher, language, year_min, year_max, price_min, price_max, min_rating, is_new, has_discount.
+// @Tags Books
+// @Produce  json
+// @Param   limit query int false "Max rows to return (default 20, max 100)"
+// @Param   offset query int false "Rows to skip (default 0)"
+// @Param   sort query string false "Column to sort by: price, rating, year, id; prefix with - for descending"
+// @Success 200  {object}  models.BooksPage
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books [get]
+func (h *BookHandler) GetAllBooks(c *gin.Context) {
+	params := parseListParams(c)
+
+	books, total, err := h.service.List(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BooksPage{
+		Data:       books,
+		Pagination: models.Pagination{Total: total, Limit: params.Limit, Offset: params.Offset},
+	})
+}
+
+// @Summary Get book by ID
+// @Description Retrieve a single book by its ID
+// @Tags Books
+// @Produce  json
+// @Param   id   path   int   true   "Book ID"
+// @Success 200  {object}  models.Book
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/{id} [get]
+func (h *BookHandler) GetBook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	book, err := h.service.GetByID(id)
+	if err == service.ErrBookNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "book not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, book)
+}
+
+// @Summary Create a new book
+// @Description Add a new book to the database
+// @Tags Books
+// @Accept  json
+// @Produce  json
+// @Param   book  body  models.Book  true  "Book Data"
+// @Success 201  {object}  models.Book
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books [post]
+func (h *BookHandler) CreateBook(c *gin.Context) {
+	var newBook models.Book
+	if err := c.ShouldBindJSON(&newBook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.service.Create(&newBook); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newBook)
+}
+
+// @Summary Update an existing book
+// @Description Update book details by ID
+// @Tags Books
+// @Accept  json
+// @Produce  json
+// @Param   id    path   int   true   "Book ID"
+// @Param   book  body   models.Book  true   "Updated Book Data"
+// @Success 200  {object}  models.Book
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books/{id} [put]
+func (h *BookHandler) UpdateBook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	var updateBook models.Book
+	if err := c.ShouldBindJSON(&updateBook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.service.Update(id, &updateBook); err == service.ErrBookNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "book not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, updateBook)
+}
+
+// @Summary Delete a book
+// @Description Delete a book by its ID
+// @Tags Books
+// @Produce  json
+// @Param   id   path   int   true   "Book ID"
+// @Success 200  {object}  map[string]string
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books/{id} [delete]
+func (h *BookHandler) DeleteBook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	if err := h.service.Delete(id); err == service.ErrBookNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "book not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "book deleted successfully"})
+}
+
+// @Summary Search books
+// @Description Full-text search books by title, author and description, ranked by relevance. Supports the same pagination, sort and filter params as GET /books.
+// @Tags Books
+// @Produce  json
+// @Param   q query string true "Full-text search query"
+// @Param   limit query int false "Max rows to return (default 20, max 100)"
+// @Param   offset query int false "Rows to skip (default 0)"
+// @Success 200  {object}  models.BooksPage
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/search [get]
+func (h *BookHandler) SearchBooks(c *gin.Context) {
+	q := c.Query("q")
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "search query 'q' is required"})
+		return
+	}
+
+	params := repository.SearchParams{ListParams: parseListParams(c), Query: q}
+	if c.Query("sort") == "" {
+		params.OrderBy = ""
+	}
+
+	books, total, err := h.service.Search(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BooksPage{
+		Data:       books,
+		Pagination: models.Pagination{Total: total, Limit: params.Limit, Offset: params.Offset},
+	})
+}
+
+// @Summary Get featured books
+// @Description Retrieve books with high ratings
+// @Tags Books
+// @Produce  json
+// @Success 200  {array}  models.Book
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/featured [get]
+func (h *BookHandler) GetFeaturedBooks(c *gin.Context) {
+	books, err := h.service.Featured()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, books)
+}
+
+// @Summary Get new books
+// @Description Retrieve books marked as new or recently added
+// @Tags Books
+// @Produce  json
+// @Success 200  {array}  models.Book
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/new [get]
+func (h *BookHandler) GetNewBooks(c *gin.Context) {
+	books, err := h.service.New()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, books)
+}
+
+// @Summary Get discounted books
+// @Description Retrieve books that are currently on discount
+// @Tags Books
+// @Produce  json
+// @Success 200  {array}  models.Book
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/discounted [get]
+func (h *BookHandler) GetDiscountedBooks(c *gin.Context) {
+	books, err := h.service.Discounted()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, books)
+}
+
+// ImportBookRequest is the payload for POST /books/import.
+type ImportBookRequest struct {
+	ISBN string `json:"isbn" binding:"required"`
+}
+
+// @Summary Import a book by ISBN
+// @Description Resolve title, author, publisher, pages, cover image, description and year from an external provider (Google Books, Open Library, Amazon) and create a book from them.
+// @Tags Books
+// @Accept  json
+// @Produce  json
+// @Param   body  body  ImportBookRequest  true  "ISBN to import"
+// @Success 201  {object}  models.Book
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books/import [post]
+func (h *BookHandler) ImportBook(c *gin.Context) {
+	var req ImportBookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	book, err := h.service.Import(req.ISBN)
+	if err == query.ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no provider has metadata for this isbn"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, book)
+}
+
+// @Summary Enrich an existing book from external metadata
+// @Description Backfill missing fields (title, author, publisher, pages, cover image, description, year) on a book from an external provider, without overwriting fields that already have a value.
+// @Tags Books
+// @Produce  json
+// @Param   id   path   int   true   "Book ID"
+// @Success 200  {object}  models.Book
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books/{id}/enrich [get]
+func (h *BookHandler) EnrichBook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	book, err := h.service.Enrich(id)
+	if err == service.ErrBookNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "book not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, book)
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/service"
+)
+
+// CategoryHandler serves the /categories routes.
+type CategoryHandler struct {
+	service *service.CategoryService
+}
+
+func NewCategoryHandler(svc *service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{service: svc}
+}
+
+// @Summary Get categories
+// @Description Retrieve categories, nested as a tree by default. Pass flat=true for a flat list, and parent_id to restrict to children of a category. Without parent_id the tree starts at the top level and the flat list holds every category.
+// @Tags Categories
+// @Produce  json
+// @Param   parent_id query int false "Restrict to children of this category"
+// @Param   flat query bool false "Return a flat list instead of a nested tree"
+// @Success 200  {array}  models.Category
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /categories [get]
+func (h *CategoryHandler) GetCategories(c *gin.Context) {
+	var parentID *int
+	if v, err := strconv.Atoi(c.Query("parent_id")); err == nil {
+		parentID = &v
+	}
+
+	flat, _ := strconv.ParseBool(c.Query("flat"))
+	if flat {
+		categories, err := h.service.Flat(parentID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, categories)
+		return
+	}
+
+	tree, err := h.service.Tree(parentID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tree)
+}
+
+// @Summary Get books in a category
+// @Description Retrieve books in a category and all of its descendant categories. Supports the same pagination, sort and filter params as GET /books.
+// @Tags Categories
+// @Produce  json
+// @Param   id   path   int   true   "Category ID"
+// @Param   limit query int false "Max rows to return (default 20, max 100)"
+// @Param   offset query int false "Rows to skip (default 0)"
+// @Success 200  {object}  models.BooksPage
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /categories/{id}/books [get]
+func (h *CategoryHandler) GetCategoryBooks(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id"})
+		return
+	}
+
+	params := parseListParams(c)
+
+	books, total, err := h.service.BooksInCategory(id, params)
+	if err == service.ErrCategoryNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "category not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BooksPage{
+		Data:       books,
+		Pagination: models.Pagination{Total: total, Limit: params.Limit, Offset: params.Offset},
+	})
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"week11-lab1/internal/repository"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// sortableColumns whitelists the columns GET /books may be sorted on, so a
+// client-supplied `sort` value can never be spliced into an ORDER BY clause
+// as arbitrary SQL.
+var sortableColumns = map[string]string{
+	"price":  "price",
+	"rating": "rating",
+	"year":   "year",
+	"id":     "id",
+}
+
+// parsePagination reads limit/offset query params. A missing or
+// non-positive limit becomes defaultLimit and a larger one is capped at
+// maxLimit; a missing or negative offset becomes 0.
+func parsePagination(c *gin.Context) (limit, offset int) {
+	limit = defaultLimit
+	if v, err := strconv.Atoi(c.Query("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	offset = 0
+	if v, err := strconv.Atoi(c.Query("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	return limit, offset
+}
+
+// parseSort turns a `sort` query param like "price" or "-rating" into an
+// ORDER BY clause, falling back to "id ASC" for an empty or unknown column.
+func parseSort(c *gin.Context) string {
+	sort := c.Query("sort")
+	if sort == "" {
+		return "id ASC"
+	}
+
+	direction := "ASC"
+	if strings.HasPrefix(sort, "-") {
+		direction = "DESC"
+		sort = sort[1:]
+	}
+
+	column, ok := sortableColumns[sort]
+	if !ok {
+		return "id ASC"
+	}
+	return column + " " + direction
+}
+
+// parseFilters reads the GET /books / GET /books/search filter query
+// params into a repository.Filters.
+func parseFilters(c *gin.Context) repository.Filters {
+	var f repository.Filters
+
+	if v, err := strconv.Atoi(c.Query("category_id")); err == nil {
+		f.CategoryID = &v
+	}
+	f.Author = c.Query("author")
+	f.Publisher = c.Query("publisher")
+	f.Language = c.Query("language")
+
+	if v, err := strconv.Atoi(c.Query("year_min")); err == nil {
+		f.YearMin = &v
+	}
+	if v, err := strconv.Atoi(c.Query("year_max")); err == nil {
+		f.YearMax = &v
+	}
+	if v, err := strconv.ParseFloat(c.Query("price_min"), 64); err == nil {
+		f.PriceMin = &v
+	}
+	if v, err := strconv.ParseFloat(c.Query("price_max"), 64); err == nil {
+		f.PriceMax = &v
+	}
+	if v, err := strconv.ParseFloat(c.Query("min_rating"), 64); err == nil {
+		f.MinRating = &v
+	}
+	if v, err := strconv.ParseBool(c.Query("is_new")); err == nil {
+		f.IsNew = &v
+	}
+	if v, err := strconv.ParseBool(c.Query("has_discount")); err == nil {
+		f.HasDiscount = v
+	}
+
+	return f
+}
+
+func parseListParams(c *gin.Context) repository.ListParams {
+	limit, offset := parsePagination(c)
+	return repository.ListParams{
+		Limit:   limit,
+		Offset:  offset,
+		OrderBy: parseSort(c),
+		Filters: parseFilters(c),
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/service"
+)
+
+// ReviewHandler serves the /books/{id}/reviews and /reviews/{id} routes.
+type ReviewHandler struct {
+	service *service.ReviewService
+}
+
+func NewReviewHandler(svc *service.ReviewService) *ReviewHandler {
+	return &ReviewHandler{service: svc}
+}
+
+// @Summary Add a review to a book
+// @Description Create a review for a book; its rating and reviews_count are recomputed from all reviews.
+// @Tags Reviews
+// @Accept  json
+// @Produce  json
+// @Param   id    path   int   true   "Book ID"
+// @Param   review  body  models.Review  true  "Review Data"
+// @Success 201  {object}  models.Review
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/books/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(c *gin.Context) {
+	bookID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	var review models.Review
+	if err := c.ShouldBindJSON(&review); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.service.Create(bookID, &review); err == service.ErrBookNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "book not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, review)
+}
+
+// @Summary List reviews for a book
+// @Tags Reviews
+// @Produce  json
+// @Param   id   path   int   true   "Book ID"
+// @Param   limit query int false "Max rows to return (default 20, max 100)"
+// @Param   offset query int false "Rows to skip (default 0)"
+// @Success 200  {object}  map[string]interface{}  "data: []models.Review, pagination: models.Pagination"
+// @Failure 400  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /books/{id}/reviews [get]
+func (h *ReviewHandler) ListReviews(c *gin.Context) {
+	bookID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid book id"})
+		return
+	}
+
+	limit, offset := parsePagination(c)
+
+	reviews, total, err := h.service.ListByBook(bookID, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":       reviews,
+		"pagination": models.Pagination{Total: total, Limit: limit, Offset: offset},
+	})
+}
+
+// @Summary Delete a review
+// @Description Delete a review by its ID; the parent book's rating and reviews_count are recomputed from the remaining reviews.
+// @Tags Reviews
+// @Produce  json
+// @Param   id   path   int   true   "Review ID"
+// @Success 200  {object}  map[string]string
+// @Failure 404  {object}  models.ErrorResponse
+// @Failure 500  {object}  models.ErrorResponse
+// @Router  /admin/reviews/{id} [delete]
+func (h *ReviewHandler) DeleteReview(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review id"})
+		return
+	}
+
+	if err := h.service.Delete(id); err == service.ErrReviewNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "review deleted successfully"})
+}
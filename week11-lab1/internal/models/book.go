@@ -0,0 +1,39 @@
+// Package models holds the data types shared across the repository,
+// service and handlers layers.
+package models
+
+import "time"
+
+// Book is a single row of the books table.
+type Book struct {
+	ID     int     `json:"id"`
+	Title  string  `json:"title" binding:"required"`
+	Author string  `json:"author" binding:"required"`
+	ISBN   string  `json:"isbn" binding:"required"`
+	Year   int     `json:"year" binding:"required"`
+	Price  float64 `json:"price" binding:"required"`
+
+	CategoryID    int         `json:"category_id" binding:"required"`
+	OriginalPrice NullFloat64 `json:"original_price"`
+	Discount      int         `json:"discount"`
+	CoverImage    string      `json:"cover_image" binding:"required"`
+	Rating        float64     `json:"rating"`
+	ReviewsCount  int         `json:"reviews_count"`
+	IsNew         bool        `json:"is_new"`
+	Pages         NullInt64   `json:"pages"`
+	Language      string      `json:"language" binding:"required"`
+	Publisher     string      `json:"publisher" binding:"required"`
+	Description   string      `json:"description"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Rank is only populated by full-text search (ts_rank against the
+	// query); featured and the other listings leave it zero. It is not a
+	// real column and is omitted when zero.
+	Rank float64 `json:"rank,omitempty"`
+}
+
+// Columns lists the book columns in the order every SELECT/scan in the
+// repository layer relies on.
+const Columns = "id, title, author, isbn, year, price, category_id, original_price, discount, cover_image, rating, reviews_count, is_new, pages, language, publisher, description, created_at, updated_at"
@@ -0,0 +1,11 @@
+package models
+
+// Category is a node in the book category tree. ParentID is nil for a
+// top-level category. Sorter controls display order among siblings.
+type Category struct {
+	ID       int         `json:"id"`
+	Name     string      `json:"name" binding:"required"`
+	ParentID *int        `json:"parent_id"`
+	Sorter   int         `json:"sorter"`
+	Children []*Category `json:"children,omitempty"`
+}
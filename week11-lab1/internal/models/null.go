@@ -0,0 +1,94 @@
+package models
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// NullString wraps sql.NullString so nullable text columns round-trip to
+// JSON as either a string or null, instead of needing *string everywhere.
+type NullString struct {
+	sql.NullString
+}
+
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+	return json.Marshal(n.String)
+}
+
+func (n *NullString) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		n.String, n.Valid = "", false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.String); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n NullString) Value() (driver.Value, error)  { return n.NullString.Value() }
+func (n *NullString) Scan(value interface{}) error { return n.NullString.Scan(value) }
+
+// NullFloat64 wraps sql.NullFloat64 so nullable numeric columns round-trip
+// to JSON as either a number or null, instead of needing *float64.
+type NullFloat64 struct {
+	sql.NullFloat64
+}
+
+func (n NullFloat64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+	return json.Marshal(n.Float64)
+}
+
+func (n *NullFloat64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		n.Float64, n.Valid = 0, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Float64); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n NullFloat64) Value() (driver.Value, error)  { return n.NullFloat64.Value() }
+func (n *NullFloat64) Scan(value interface{}) error { return n.NullFloat64.Scan(value) }
+
+// NullInt64 wraps sql.NullInt64 so nullable integer columns round-trip to
+// JSON as either a number or null, instead of needing *int.
+type NullInt64 struct {
+	sql.NullInt64
+}
+
+func (n NullInt64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+	return json.Marshal(n.Int64)
+}
+
+func (n *NullInt64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		n.Int64, n.Valid = 0, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Int64); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n NullInt64) Value() (driver.Value, error)  { return n.NullInt64.Value() }
+func (n *NullInt64) Scan(value interface{}) error { return n.NullInt64.Scan(value) }
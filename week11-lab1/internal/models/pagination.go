@@ -0,0 +1,16 @@
+package models
+
+// Pagination describes the page of results returned alongside "data" by
+// the listing/search endpoints.
+type Pagination struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// BooksPage is the response body shape shared by every paginated books
+// endpoint: { "data": [...], "pagination": {...} }.
+type BooksPage struct {
+	Data       []Book     `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
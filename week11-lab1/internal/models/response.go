@@ -0,0 +1,6 @@
+package models
+
+// ErrorResponse is the JSON body returned for non-2xx responses.
+type ErrorResponse struct {
+	Message string `json:"error"`
+}
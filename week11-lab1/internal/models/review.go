@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Review is a single reader review for a book. A book's Rating and
+// ReviewsCount are recomputed from its reviews whenever a review is
+// created or deleted, and by RecomputeAllAggregates. Creating or updating
+// a book writes Rating and ReviewsCount as given in the request body; the
+// next recompute for that book overwrites them.
+type Review struct {
+	ID        int       `json:"id"`
+	BookID    int       `json:"book_id"`
+	User      string    `json:"user" binding:"required"`
+	Rating    int       `json:"rating" binding:"required,min=1,max=5"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
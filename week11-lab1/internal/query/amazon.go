@@ -0,0 +1,129 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// AmazonProvider resolves metadata via the Amazon Product Advertising API.
+// It requires credentials in AMAZON_ACCESS_KEY, AMAZON_SECRET_KEY and
+// AMAZON_PARTNER_TAG; if any are missing the provider is disabled and
+// every lookup returns ErrNotFound so fallback ordering can skip it.
+type AmazonProvider struct {
+	httpClient *http.Client
+	baseURL    string
+	accessKey  string
+	secretKey  string
+	partnerTag string
+}
+
+func NewAmazonProvider() *AmazonProvider {
+	return &AmazonProvider{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		baseURL:    "https://webservices.amazon.com/paapi5/getitems",
+		accessKey:  os.Getenv("AMAZON_ACCESS_KEY"),
+		secretKey:  os.Getenv("AMAZON_SECRET_KEY"),
+		partnerTag: os.Getenv("AMAZON_PARTNER_TAG"),
+	}
+}
+
+func (p *AmazonProvider) Name() string { return "amazon" }
+
+func (p *AmazonProvider) configured() bool {
+	return p.accessKey != "" && p.secretKey != "" && p.partnerTag != ""
+}
+
+func (p *AmazonProvider) LookupISBN(isbn string) (*Book, error) {
+	if !p.configured() {
+		return nil, ErrNotFound
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"ItemIds":     []string{isbn},
+		"ItemIdType":  "ISBN",
+		"PartnerTag":  p.partnerTag,
+		"PartnerType": "Associates",
+		"Resources":   []string{"ItemInfo.Title", "ItemInfo.ByLineInfo", "ItemInfo.ContentInfo", "Images.Primary.Large"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("amazon: build request for isbn %s: %w", isbn, err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.baseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("amazon: build request for isbn %s: %w", isbn, err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Content-Encoding", "amz-1.0")
+	req.Header.Set("X-Amz-Target", "com.amazon.paapi5.v1.ProductAdvertisingAPIv1.GetItems")
+	signRequest(req, body, p.accessKey, p.secretKey)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("amazon: request isbn %s: %w", isbn, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("amazon: unexpected status %d for isbn %s", resp.StatusCode, isbn)
+	}
+
+	var payload struct {
+		ItemsResult struct {
+			Items []struct {
+				ItemInfo struct {
+					Title struct {
+						DisplayValue string `json:"DisplayValue"`
+					} `json:"Title"`
+					ByLineInfo struct {
+						Contributors []struct {
+							Name string `json:"Name"`
+							Role string `json:"Role"`
+						} `json:"Contributors"`
+						Manufacturer struct {
+							DisplayValue string `json:"DisplayValue"`
+						} `json:"Manufacturer"`
+					} `json:"ByLineInfo"`
+				} `json:"ItemInfo"`
+				Images struct {
+					Primary struct {
+						Large struct {
+							URL string `json:"URL"`
+						} `json:"Large"`
+					} `json:"Primary"`
+				} `json:"Images"`
+			} `json:"Items"`
+		} `json:"ItemsResult"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("amazon: decode response for isbn %s: %w", isbn, err)
+	}
+
+	if len(payload.ItemsResult.Items) == 0 {
+		return nil, ErrNotFound
+	}
+
+	item := payload.ItemsResult.Items[0]
+	var author string
+	for _, c := range item.ItemInfo.ByLineInfo.Contributors {
+		if c.Role == "Author" {
+			author = c.Name
+			break
+		}
+	}
+
+	return &Book{
+		Title:      item.ItemInfo.Title.DisplayValue,
+		Author:     author,
+		Publisher:  item.ItemInfo.ByLineInfo.Manufacturer.DisplayValue,
+		CoverImage: item.Images.Primary.Large.URL,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package query
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// paapi5Service and paapi5Region are fixed by the Product Advertising API;
+// it only accepts requests signed for this service name and (for the
+// default .com marketplace) this region.
+const (
+	paapi5Service = "ProductAdvertisingAPI"
+	paapi5Region  = "us-east-1"
+)
+
+// signRequest signs req with AWS Signature Version 4, as required by
+// every Product Advertising API request. It sets the Host, X-Amz-Date and
+// Authorization headers; req.Header must already carry every other header
+// that should be part of the signature (Content-Type, X-Amz-Target, ...).
+func signRequest(req *http.Request, body []byte, accessKey, secretKey string) {
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+
+	req.Header.Set("Host", req.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(req)
+	canonicalRequest := req.Method + "\n" +
+		req.URL.EscapedPath() + "\n" +
+		req.URL.RawQuery + "\n" +
+		canonicalHeaders + "\n" +
+		signedHeaders + "\n" +
+		hashHex(body)
+
+	credentialScope := dateStamp + "/" + paapi5Region + "/" + paapi5Service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" +
+		amzDate + "\n" +
+		credentialScope + "\n" +
+		hashHex([]byte(canonicalRequest))
+
+	signingKey := hmacSHA256(hmacSHA256(hmacSHA256(hmacSHA256([]byte("AWS4"+secretKey), dateStamp), paapi5Region), paapi5Service), "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	authHeader := "AWS4-HMAC-SHA256 Credential=" + accessKey + "/" + credentialScope +
+		", SignedHeaders=" + signedHeaders +
+		", Signature=" + signature
+	req.Header.Set("Authorization", authHeader)
+}
+
+// canonicalizeHeaders returns the fixed set of headers signed for a
+// Product Advertising API request (content-encoding, content-type, host,
+// x-amz-date, x-amz-target), lower-cased, sorted and already in the
+// "name:value\n" form SigV4 expects, along with the semicolon-joined list
+// of signed header names. Any other header on req is not signed.
+func canonicalizeHeaders(req *http.Request) (canonical, signed string) {
+	names := []string{"content-encoding", "content-type", "host", "x-amz-date", "x-amz-target"}
+
+	for _, name := range names {
+		canonical += name + ":" + req.Header.Get(http.CanonicalHeaderKey(name)) + "\n"
+		signed += name + ";"
+	}
+	signed = signed[:len(signed)-1]
+	return canonical, signed
+}
+
+func hashHex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
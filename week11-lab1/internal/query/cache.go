@@ -0,0 +1,65 @@
+package query
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a small fixed-size, in-memory LRU cache keyed by ISBN, used to
+// avoid re-hitting external providers for repeated imports of the same book.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type cacheEntry struct {
+	isbn string
+	book *Book
+}
+
+func newLRUCache(capacity int) *lruCache {
+	if capacity <= 0 {
+		capacity = 128
+	}
+	return &lruCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *lruCache) Get(isbn string) (*Book, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[isbn]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*cacheEntry).book, true
+}
+
+func (c *lruCache) Set(isbn string, book *Book) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[isbn]; ok {
+		el.Value.(*cacheEntry).book = book
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&cacheEntry{isbn: isbn, book: book})
+	c.items[isbn] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*cacheEntry).isbn)
+		}
+	}
+}
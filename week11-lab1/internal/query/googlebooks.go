@@ -0,0 +1,90 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GoogleBooksProvider resolves metadata from the public Google Books
+// volumes API (https://www.googleapis.com/books/v1/volumes).
+type GoogleBooksProvider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+func NewGoogleBooksProvider() *GoogleBooksProvider {
+	return &GoogleBooksProvider{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		baseURL:    "https://www.googleapis.com/books/v1/volumes",
+	}
+}
+
+func (p *GoogleBooksProvider) Name() string { return "googlebooks" }
+
+func (p *GoogleBooksProvider) LookupISBN(isbn string) (*Book, error) {
+	url := fmt.Sprintf("%s?q=isbn:%s", p.baseURL, isbn)
+
+	resp, err := p.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("googlebooks: request isbn %s: %w", isbn, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("googlebooks: unexpected status %d for isbn %s", resp.StatusCode, isbn)
+	}
+
+	var payload struct {
+		Items []struct {
+			VolumeInfo struct {
+				Title         string   `json:"title"`
+				Authors       []string `json:"authors"`
+				Publisher     string   `json:"publisher"`
+				Description   string   `json:"description"`
+				PageCount     int      `json:"pageCount"`
+				Language      string   `json:"language"`
+				PublishedDate string   `json:"publishedDate"`
+				ImageLinks    struct {
+					Thumbnail string `json:"thumbnail"`
+				} `json:"imageLinks"`
+			} `json:"volumeInfo"`
+		} `json:"items"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("googlebooks: decode response for isbn %s: %w", isbn, err)
+	}
+
+	if len(payload.Items) == 0 {
+		return nil, ErrNotFound
+	}
+
+	info := payload.Items[0].VolumeInfo
+	return &Book{
+		Title:       info.Title,
+		Author:      strings.Join(info.Authors, ", "),
+		Publisher:   info.Publisher,
+		CoverImage:  info.ImageLinks.Thumbnail,
+		Description: info.Description,
+		Language:    info.Language,
+		Year:        parseYear(info.PublishedDate),
+		Pages:       info.PageCount,
+	}, nil
+}
+
+// parseYear pulls the leading 4-digit year out of an ISO-ish date string
+// such as "2015" or "2015-03-27"; it returns 0 if none is found.
+func parseYear(date string) int {
+	if len(date) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(date[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenLibraryProvider resolves metadata from the Open Library "by ISBN"
+// API (https://openlibrary.org/api/books).
+type OpenLibraryProvider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+func NewOpenLibraryProvider() *OpenLibraryProvider {
+	return &OpenLibraryProvider{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		baseURL:    "https://openlibrary.org/api/books",
+	}
+}
+
+func (p *OpenLibraryProvider) Name() string { return "openlibrary" }
+
+func (p *OpenLibraryProvider) LookupISBN(isbn string) (*Book, error) {
+	key := "ISBN:" + isbn
+	url := fmt.Sprintf("%s?bibkeys=%s&format=json&jscmd=data", p.baseURL, key)
+
+	resp, err := p.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("openlibrary: request isbn %s: %w", isbn, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openlibrary: unexpected status %d for isbn %s", resp.StatusCode, isbn)
+	}
+
+	var payload map[string]struct {
+		Title      string `json:"title"`
+		Publishers []struct {
+			Name string `json:"name"`
+		} `json:"publishers"`
+		Authors []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		NumberOfPages int    `json:"number_of_pages"`
+		PublishDate   string `json:"publish_date"`
+		Cover         struct {
+			Medium string `json:"medium"`
+		} `json:"cover"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("openlibrary: decode response for isbn %s: %w", isbn, err)
+	}
+
+	entry, ok := payload[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	authors := make([]string, 0, len(entry.Authors))
+	for _, a := range entry.Authors {
+		authors = append(authors, a.Name)
+	}
+
+	var publisher string
+	if len(entry.Publishers) > 0 {
+		publisher = entry.Publishers[0].Name
+	}
+
+	return &Book{
+		Title:      entry.Title,
+		Author:     strings.Join(authors, ", "),
+		Publisher:  publisher,
+		CoverImage: entry.Cover.Medium,
+		Pages:      entry.NumberOfPages,
+		Year:       parseYear(entry.PublishDate),
+	}, nil
+}
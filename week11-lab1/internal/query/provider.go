@@ -0,0 +1,35 @@
+// Package query resolves book metadata (title, author, cover, ...) from
+// external catalog providers, given an ISBN.
+package query
+
+import "fmt"
+
+// Book is the subset of metadata a MetadataProvider can resolve for an ISBN.
+// Zero values mean "the provider didn't know" and callers should treat them
+// as absent rather than as real data.
+type Book struct {
+	Title       string
+	Author      string
+	Publisher   string
+	CoverImage  string
+	Description string
+	Language    string
+	Year        int
+	Pages       int
+}
+
+// MetadataProvider looks up book metadata for a single ISBN from one
+// external catalog (Google Books, Open Library, Amazon, ...).
+type MetadataProvider interface {
+	// Name identifies the provider, e.g. for logging and env var config.
+	Name() string
+	// LookupISBN returns metadata for isbn, or ErrNotFound if the provider
+	// has nothing for it.
+	LookupISBN(isbn string) (*Book, error)
+}
+
+// ErrNotFound is returned by a MetadataProvider when the ISBN is not in its
+// catalog. It is distinct from transport/parse errors so a Registry can
+// report "not found" when no provider has the ISBN, and a failure when at
+// least one provider errored; either way it tries the next provider.
+var ErrNotFound = fmt.Errorf("query: isbn not found")
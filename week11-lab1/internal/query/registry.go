@@ -0,0 +1,78 @@
+package query
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Registry looks up book metadata by trying a configurable, ordered list of
+// providers until one returns a result, caching hits by ISBN.
+type Registry struct {
+	providers []MetadataProvider
+	cache     *lruCache
+}
+
+// NewRegistry builds a Registry from the given providers, in fallback
+// order, backed by a cache holding up to cacheSize ISBNs.
+func NewRegistry(cacheSize int, providers ...MetadataProvider) *Registry {
+	return &Registry{
+		providers: providers,
+		cache:     newLRUCache(cacheSize),
+	}
+}
+
+// NewRegistryFromEnv builds the default provider set ordered by the
+// METADATA_PROVIDERS env var, a comma-separated list such as
+// "googlebooks,openlibrary,amazon". Unknown names are ignored; if the
+// variable is unset or empty, all known providers are used in that order.
+func NewRegistryFromEnv() *Registry {
+	available := map[string]MetadataProvider{
+		"googlebooks": NewGoogleBooksProvider(),
+		"openlibrary": NewOpenLibraryProvider(),
+		"amazon":      NewAmazonProvider(),
+	}
+	order := []string{"googlebooks", "openlibrary", "amazon"}
+
+	if raw := os.Getenv("METADATA_PROVIDERS"); raw != "" {
+		order = order[:0]
+		for _, name := range strings.Split(raw, ",") {
+			name = strings.TrimSpace(name)
+			if _, ok := available[name]; ok {
+				order = append(order, name)
+			}
+		}
+	}
+
+	providers := make([]MetadataProvider, 0, len(order))
+	for _, name := range order {
+		providers = append(providers, available[name])
+	}
+
+	return NewRegistry(256, providers...)
+}
+
+// LookupISBN queries the cache first, then tries each configured provider
+// in order, caching and returning the first match.
+func (r *Registry) LookupISBN(isbn string) (*Book, error) {
+	if book, ok := r.cache.Get(isbn); ok {
+		return book, nil
+	}
+
+	var lastErr error
+	for _, p := range r.providers {
+		book, err := p.LookupISBN(isbn)
+		if err == nil {
+			r.cache.Set(isbn, book)
+			return book, nil
+		}
+		if err != ErrNotFound {
+			lastErr = err
+		}
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("query: all providers failed for isbn %s: %w", isbn, lastErr)
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,21 @@
+// Package repository provides data access for books, behind the
+// BookRepository interface, so the service layer can be unit-tested
+// against a mock instead of a real database.
+package repository
+
+import "week11-lab1/internal/models"
+
+// BookRepository is the data access surface the service layer depends on.
+type BookRepository interface {
+	List(params ListParams) (books []models.Book, total int, err error)
+	Search(params SearchParams) (books []models.Book, total int, err error)
+	GetByID(id int) (*models.Book, error)
+	Create(book *models.Book) error
+	Update(id int, book *models.Book) error
+	Delete(id int) error
+	Featured() ([]models.Book, error)
+	New() ([]models.Book, error)
+	Discounted() ([]models.Book, error)
+	UpdateMetadata(id int, book *models.Book) error
+	Seed(books []models.Book) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "week11-lab1/internal/models"
+
+// CategoryRepository is the data access surface for categories.
+type CategoryRepository interface {
+	// All returns every category, flat, for the caller to filter or
+	// assemble into a tree.
+	All() ([]models.Category, error)
+	GetByID(id int) (*models.Category, error)
+	// BooksInCategory returns books whose category is id or a descendant
+	// of id, via a recursive CTE over the category tree.
+	BooksInCategory(id int, params ListParams) (books []models.Book, total int, err error)
+	// GetOrCreateByName returns the id of the top-level category named
+	// name, creating it if it doesn't exist yet. Used to bucket
+	// imported books that don't carry category information.
+	GetOrCreateByName(name string) (int, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+// Filters holds the optional GET /books / GET /books/search filter values.
+// A nil pointer means "not provided".
+type Filters struct {
+	CategoryID  *int
+	Author      string
+	Publisher   string
+	Language    string
+	YearMin     *int
+	YearMax     *int
+	PriceMin    *float64
+	PriceMax    *float64
+	MinRating   *float64
+	IsNew       *bool
+	HasDiscount bool
+}
+
+// ListParams is the validated input to BookRepository.List/Search: pagination
+// and sort have already been clamped/whitelisted by the caller.
+type ListParams struct {
+	Limit   int
+	Offset  int
+	OrderBy string // e.g. "price DESC"; must come from a column whitelist
+	Filters Filters
+}
+
+// SearchParams extends ListParams with the full-text search query.
+type SearchParams struct {
+	ListParams
+	Query string
+}
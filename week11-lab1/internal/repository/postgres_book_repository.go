@@ -0,0 +1,357 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"week11-lab1/internal/models"
+)
+
+// PostgresBookRepository implements BookRepository on top of *sql.DB,
+// using statements prepared once at construction time for every
+// fixed-shape query. List and Search build their WHERE/ORDER BY per call
+// since they vary with caller-supplied filters and cannot be prepared
+// once.
+type PostgresBookRepository struct {
+	db *sql.DB
+
+	stmtGetByID    *sql.Stmt
+	stmtInsert     *sql.Stmt
+	stmtUpdate     *sql.Stmt
+	stmtUpdateMeta *sql.Stmt
+	stmtDelete     *sql.Stmt
+	stmtFeatured   *sql.Stmt
+	stmtNew        *sql.Stmt
+	stmtDiscounted *sql.Stmt
+}
+
+// NewPostgresBookRepository prepares every fixed-shape statement up front
+// so request handling never pays query-planning cost or risks a
+// malformed fmt.Sprintf query string.
+func NewPostgresBookRepository(db *sql.DB) (*PostgresBookRepository, error) {
+	r := &PostgresBookRepository{db: db}
+
+	statements := []struct {
+		dst   **sql.Stmt
+		query string
+	}{
+		{&r.stmtGetByID, fmt.Sprintf("SELECT %s FROM books WHERE id = $1", models.Columns)},
+		{&r.stmtInsert, fmt.Sprintf(
+			`INSERT INTO books (title, author, isbn, year, price, category_id, original_price, discount, cover_image, rating, reviews_count, is_new, pages, language, publisher, description)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			 RETURNING id, created_at, updated_at`,
+		)},
+		{&r.stmtUpdate, `
+			UPDATE books SET
+				title = $1, author = $2, isbn = $3, year = $4, price = $5,
+				category_id = $6, original_price = $7, discount = $8, cover_image = $9,
+				rating = $10, reviews_count = $11, is_new = $12, pages = $13,
+				language = $14, publisher = $15, description = $16
+			WHERE id = $17
+			RETURNING updated_at`,
+		},
+		{&r.stmtUpdateMeta, `
+			UPDATE books SET
+				title = $1, author = $2, cover_image = $3, pages = $4,
+				language = $5, publisher = $6, description = $7, year = $8
+			WHERE id = $9
+			RETURNING updated_at`,
+		},
+		{&r.stmtDelete, "DELETE FROM books WHERE id = $1"},
+		{&r.stmtFeatured, fmt.Sprintf(
+			"SELECT %s FROM books WHERE rating >= 4.5 ORDER BY rating DESC, reviews_count DESC LIMIT 10", models.Columns,
+		)},
+		{&r.stmtNew, fmt.Sprintf(
+			"SELECT %s FROM books WHERE is_new = TRUE OR created_at >= (NOW() - INTERVAL '30 DAYS') ORDER BY created_at DESC LIMIT 10", models.Columns,
+		)},
+		{&r.stmtDiscounted, fmt.Sprintf(
+			"SELECT %s FROM books WHERE discount > 0 ORDER BY discount DESC, title ASC LIMIT 20", models.Columns,
+		)},
+	}
+
+	for _, s := range statements {
+		stmt, err := db.Prepare(s.query)
+		if err != nil {
+			return nil, fmt.Errorf("repository: prepare statement: %w", err)
+		}
+		*s.dst = stmt
+	}
+
+	return r, nil
+}
+
+func scanBook(row *sql.Row, book *models.Book) error {
+	return row.Scan(
+		&book.ID, &book.Title, &book.Author, &book.ISBN, &book.Year, &book.Price,
+		&book.CategoryID, &book.OriginalPrice, &book.Discount, &book.CoverImage,
+		&book.Rating, &book.ReviewsCount, &book.IsNew, &book.Pages, &book.Language,
+		&book.Publisher, &book.Description, &book.CreatedAt, &book.UpdatedAt,
+	)
+}
+
+func scanBookRows(rows *sql.Rows, book *models.Book) error {
+	return rows.Scan(
+		&book.ID, &book.Title, &book.Author, &book.ISBN, &book.Year, &book.Price,
+		&book.CategoryID, &book.OriginalPrice, &book.Discount, &book.CoverImage,
+		&book.Rating, &book.ReviewsCount, &book.IsNew, &book.Pages, &book.Language,
+		&book.Publisher, &book.Description, &book.CreatedAt, &book.UpdatedAt,
+	)
+}
+
+// scanRankedBookRow scans a row from List/Search, i.e. the usual book
+// columns plus a "rank" column and a "total_count" window-function column
+// giving the total rows matched regardless of limit/offset.
+func scanRankedBookRow(rows *sql.Rows, book *models.Book) (total int, err error) {
+	err = rows.Scan(
+		&book.ID, &book.Title, &book.Author, &book.ISBN, &book.Year, &book.Price,
+		&book.CategoryID, &book.OriginalPrice, &book.Discount, &book.CoverImage,
+		&book.Rating, &book.ReviewsCount, &book.IsNew, &book.Pages, &book.Language,
+		&book.Publisher, &book.Description, &book.CreatedAt, &book.UpdatedAt,
+		&book.Rank, &total,
+	)
+	return total, err
+}
+
+func (r *PostgresBookRepository) GetByID(id int) (*models.Book, error) {
+	var book models.Book
+	if err := scanBook(r.stmtGetByID.QueryRow(id), &book); err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+func (r *PostgresBookRepository) Create(book *models.Book) error {
+	return r.stmtInsert.QueryRow(
+		book.Title, book.Author, book.ISBN, book.Year, book.Price,
+		book.CategoryID, book.OriginalPrice, book.Discount, book.CoverImage,
+		book.Rating, book.ReviewsCount, book.IsNew, book.Pages, book.Language,
+		book.Publisher, book.Description,
+	).Scan(&book.ID, &book.CreatedAt, &book.UpdatedAt)
+}
+
+func (r *PostgresBookRepository) Update(id int, book *models.Book) error {
+	err := r.stmtUpdate.QueryRow(
+		book.Title, book.Author, book.ISBN, book.Year, book.Price,
+		book.CategoryID, book.OriginalPrice, book.Discount, book.CoverImage,
+		book.Rating, book.ReviewsCount, book.IsNew, book.Pages,
+		book.Language, book.Publisher, book.Description,
+		id,
+	).Scan(&book.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	book.ID = id
+	return nil
+}
+
+func (r *PostgresBookRepository) UpdateMetadata(id int, book *models.Book) error {
+	return r.stmtUpdateMeta.QueryRow(
+		book.Title, book.Author, book.CoverImage, book.Pages,
+		book.Language, book.Publisher, book.Description, book.Year,
+		id,
+	).Scan(&book.UpdatedAt)
+}
+
+func (r *PostgresBookRepository) Delete(id int) error {
+	result, err := r.stmtDelete.Exec(id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *PostgresBookRepository) Featured() ([]models.Book, error) {
+	return queryBooks(r.stmtFeatured)
+}
+
+func (r *PostgresBookRepository) New() ([]models.Book, error) {
+	return queryBooks(r.stmtNew)
+}
+
+func (r *PostgresBookRepository) Discounted() ([]models.Book, error) {
+	return queryBooks(r.stmtDiscounted)
+}
+
+func queryBooks(stmt *sql.Stmt) ([]models.Book, error) {
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := []models.Book{}
+	for rows.Next() {
+		var book models.Book
+		if err := scanBookRows(rows, &book); err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
+// List returns a page of books matching params.Filters, ordered by
+// params.OrderBy, alongside the total row count matched (ignoring
+// limit/offset). The WHERE/ORDER BY clause varies per call with the
+// caller's filters, so unlike the fixed-shape queries above it cannot be
+// prepared once at startup.
+func (r *PostgresBookRepository) List(params ListParams) ([]models.Book, int, error) {
+	whereClause, args := buildFilterClause(params.Filters, 1)
+	query, args := buildRankedQuery("0", whereClause, params.OrderBy, args, params.Limit, params.Offset)
+	return runRankedBookQuery(r.db, query, args)
+}
+
+// Search is List with an added full-text match against books_fts, ranked
+// by ts_rank unless the caller requested an explicit sort column.
+func (r *PostgresBookRepository) Search(params SearchParams) ([]models.Book, int, error) {
+	tsquery := "plainto_tsquery('english', $1)"
+	whereClause, args := buildFilterClause(params.Filters, 2)
+	args = append([]interface{}{params.Query}, args...)
+
+	fullTextCondition := fmt.Sprintf("books_fts @@ %s", tsquery)
+	if whereClause == "" {
+		whereClause = " WHERE " + fullTextCondition
+	} else {
+		whereClause += " AND " + fullTextCondition
+	}
+
+	orderBy := params.OrderBy
+	if orderBy == "" {
+		orderBy = "rank DESC"
+	}
+
+	rankExpr := fmt.Sprintf("ts_rank(books_fts, %s)", tsquery)
+	query, args := buildRankedQuery(rankExpr, whereClause, orderBy, args, params.Limit, params.Offset)
+	return runRankedBookQuery(r.db, query, args)
+}
+
+// runRankedBookQuery executes a query built by buildRankedQuery (shared by
+// PostgresBookRepository and PostgresCategoryRepository) and scans every
+// row into a models.Book, returning the total match count from the last
+// row's window-function column.
+func runRankedBookQuery(db *sql.DB, query string, args []interface{}) ([]models.Book, int, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	books := []models.Book{}
+	total := 0
+	for rows.Next() {
+		var book models.Book
+		total, err = scanRankedBookRow(rows, &book)
+		if err != nil {
+			return nil, 0, err
+		}
+		books = append(books, book)
+	}
+	return books, total, rows.Err()
+}
+
+// buildRankedQuery assembles a SELECT shared by List and Search: the usual
+// book columns, a caller-supplied rank expression, and a
+// COUNT(*) OVER() window so the total match count comes back in the same
+// query as the page of rows. limit/offset are appended after args.
+func buildRankedQuery(rankExpr, whereClause, orderBy string, args []interface{}, limit, offset int) (string, []interface{}) {
+	limitPlaceholder := len(args) + 1
+	offsetPlaceholder := len(args) + 2
+
+	query := fmt.Sprintf(
+		"SELECT %s, %s AS rank, COUNT(*) OVER() AS total_count FROM books%s ORDER BY %s LIMIT $%d OFFSET $%d",
+		models.Columns, rankExpr, whereClause, orderBy, limitPlaceholder, offsetPlaceholder,
+	)
+	return query, append(args, limit, offset)
+}
+
+// buildFilterClause builds a WHERE clause and its positional args from
+// filters, continuing placeholder numbering from startAt so callers can
+// append their own placeholders (e.g. a search query) before these.
+func buildFilterClause(filters Filters, startAt int) (whereClause string, args []interface{}) {
+	var conditions []string
+	args = []interface{}{}
+	next := startAt
+
+	add := func(condition string, value interface{}) {
+		conditions = append(conditions, fmt.Sprintf(condition, next))
+		args = append(args, value)
+		next++
+	}
+
+	if filters.CategoryID != nil {
+		add("category_id = $%d", *filters.CategoryID)
+	}
+	if filters.Author != "" {
+		add("author ILIKE $%d", "%"+filters.Author+"%")
+	}
+	if filters.Publisher != "" {
+		add("publisher ILIKE $%d", "%"+filters.Publisher+"%")
+	}
+	if filters.Language != "" {
+		add("language = $%d", filters.Language)
+	}
+	if filters.YearMin != nil {
+		add("year >= $%d", *filters.YearMin)
+	}
+	if filters.YearMax != nil {
+		add("year <= $%d", *filters.YearMax)
+	}
+	if filters.PriceMin != nil {
+		add("price >= $%d", *filters.PriceMin)
+	}
+	if filters.PriceMax != nil {
+		add("price <= $%d", *filters.PriceMax)
+	}
+	if filters.MinRating != nil {
+		add("rating >= $%d", *filters.MinRating)
+	}
+	if filters.IsNew != nil {
+		add("is_new = $%d", *filters.IsNew)
+	}
+	if filters.HasDiscount {
+		conditions = append(conditions, "discount > 0")
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// Seed clears the books table and inserts the given books, in a single
+// transaction, so a fresh database has something to browse.
+func (r *PostgresBookRepository) Seed(books []models.Book) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("TRUNCATE TABLE books RESTART IDENTITY CASCADE"); err != nil {
+		return fmt.Errorf("repository: clear books table: %w", err)
+	}
+
+	for _, book := range books {
+		_, err := tx.Exec(
+			`INSERT INTO books (title, author, isbn, year, price, category_id, original_price, discount, cover_image, rating, reviews_count, is_new, pages, language, publisher, description)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+			book.Title, book.Author, book.ISBN, book.Year, book.Price,
+			book.CategoryID, book.OriginalPrice, book.Discount, book.CoverImage,
+			book.Rating, book.ReviewsCount, book.IsNew, book.Pages, book.Language,
+			book.Publisher, book.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("repository: seed book %q: %w", book.Title, err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"week11-lab1/internal/models"
+)
+
+// PostgresCategoryRepository implements CategoryRepository on top of
+// *sql.DB.
+type PostgresCategoryRepository struct {
+	db *sql.DB
+
+	stmtAll    *sql.Stmt
+	stmtByID   *sql.Stmt
+	stmtByName *sql.Stmt
+	stmtInsert *sql.Stmt
+}
+
+func NewPostgresCategoryRepository(db *sql.DB) (*PostgresCategoryRepository, error) {
+	r := &PostgresCategoryRepository{db: db}
+
+	statements := []struct {
+		dst   **sql.Stmt
+		query string
+	}{
+		{&r.stmtAll, "SELECT id, name, parent_id, sorter FROM categories ORDER BY sorter, name"},
+		{&r.stmtByID, "SELECT id, name, parent_id, sorter FROM categories WHERE id = $1"},
+		{&r.stmtByName, "SELECT id FROM categories WHERE parent_id IS NULL AND name = $1"},
+		{&r.stmtInsert, "INSERT INTO categories (name) VALUES ($1) RETURNING id"},
+	}
+
+	for _, s := range statements {
+		stmt, err := db.Prepare(s.query)
+		if err != nil {
+			return nil, fmt.Errorf("repository: prepare statement: %w", err)
+		}
+		*s.dst = stmt
+	}
+
+	return r, nil
+}
+
+func (r *PostgresCategoryRepository) All() ([]models.Category, error) {
+	rows, err := r.stmtAll.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []models.Category{}
+	for rows.Next() {
+		var category models.Category
+		if err := rows.Scan(&category.ID, &category.Name, &category.ParentID, &category.Sorter); err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+	return categories, rows.Err()
+}
+
+func (r *PostgresCategoryRepository) GetByID(id int) (*models.Category, error) {
+	var category models.Category
+	err := r.stmtByID.QueryRow(id).Scan(&category.ID, &category.Name, &category.ParentID, &category.Sorter)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// BooksInCategory returns books whose category_id is id or belongs to a
+// descendant category, found with a recursive CTE walking parent_id down
+// from id.
+func (r *PostgresCategoryRepository) BooksInCategory(id int, params ListParams) ([]models.Book, int, error) {
+	whereClause, filterArgs := buildFilterClause(params.Filters, 2)
+
+	condition := "category_id IN (SELECT id FROM category_descendants)"
+	if whereClause == "" {
+		whereClause = " WHERE " + condition
+	} else {
+		whereClause += " AND " + condition
+	}
+
+	args := append([]interface{}{id}, filterArgs...)
+	selectQuery, args := buildRankedQuery("0", whereClause, params.OrderBy, args, params.Limit, params.Offset)
+
+	query := `WITH RECURSIVE category_descendants AS (
+		SELECT id FROM categories WHERE id = $1
+		UNION ALL
+		SELECT c.id FROM categories c JOIN category_descendants d ON c.parent_id = d.id
+	) ` + selectQuery
+
+	return runRankedBookQuery(r.db, query, args)
+}
+
+func (r *PostgresCategoryRepository) GetOrCreateByName(name string) (int, error) {
+	var id int
+	err := r.stmtByName.QueryRow(name).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	if err := r.stmtInsert.QueryRow(name).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
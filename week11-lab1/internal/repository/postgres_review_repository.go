@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"week11-lab1/internal/models"
+)
+
+// PostgresReviewRepository implements ReviewRepository on top of *sql.DB.
+// Create and Delete run inside a transaction that locks the parent book
+// row (SELECT ... FOR UPDATE) before touching reviews, so concurrent
+// writes to the same book can't race each other's aggregate recompute.
+type PostgresReviewRepository struct {
+	db *sql.DB
+
+	stmtListByBook *sql.Stmt
+}
+
+func NewPostgresReviewRepository(db *sql.DB) (*PostgresReviewRepository, error) {
+	stmt, err := db.Prepare(`
+		SELECT id, book_id, "user", rating, comment, created_at, COUNT(*) OVER()
+		FROM reviews WHERE book_id = $1
+		ORDER BY created_at DESC LIMIT $2 OFFSET $3`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("repository: prepare statement: %w", err)
+	}
+	return &PostgresReviewRepository{db: db, stmtListByBook: stmt}, nil
+}
+
+func (r *PostgresReviewRepository) Create(review *models.Review) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT id FROM books WHERE id = $1 FOR UPDATE", review.BookID); err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
+		`INSERT INTO reviews (book_id, "user", rating, comment) VALUES ($1, $2, $3, $4) RETURNING id, created_at`,
+		review.BookID, review.User, review.Rating, review.Comment,
+	).Scan(&review.ID, &review.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := recomputeBookAggregates(tx, review.BookID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresReviewRepository) ListByBook(bookID, limit, offset int) ([]models.Review, int, error) {
+	rows, err := r.stmtListByBook.Query(bookID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	reviews := []models.Review{}
+	total := 0
+	for rows.Next() {
+		var review models.Review
+		if err := rows.Scan(&review.ID, &review.BookID, &review.User, &review.Rating, &review.Comment, &review.CreatedAt, &total); err != nil {
+			return nil, 0, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, total, rows.Err()
+}
+
+func (r *PostgresReviewRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var bookID int
+	if err := tx.QueryRow("SELECT book_id FROM reviews WHERE id = $1", id).Scan(&bookID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("SELECT id FROM books WHERE id = $1 FOR UPDATE", bookID); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec("DELETE FROM reviews WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := recomputeBookAggregates(tx, bookID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// recomputeBookAggregates sets books.rating/reviews_count on bookID from
+// the current rows in reviews. The caller must already hold the book row
+// lock (SELECT ... FOR UPDATE) within tx.
+func recomputeBookAggregates(tx *sql.Tx, bookID int) error {
+	_, err := tx.Exec(`
+		UPDATE books SET
+			rating = COALESCE((SELECT AVG(rating) FROM reviews WHERE book_id = $1), 0),
+			reviews_count = (SELECT COUNT(*) FROM reviews WHERE book_id = $1)
+		WHERE id = $1`,
+		bookID,
+	)
+	return err
+}
+
+// RecomputeAllAggregates recalculates every book's rating/reviews_count
+// from its reviews, one book at a time under its own row lock. Used to
+// backfill existing data and by the -recompute-aggregates nightly job.
+func (r *PostgresReviewRepository) RecomputeAllAggregates() error {
+	rows, err := r.db.Query("SELECT id FROM books")
+	if err != nil {
+		return err
+	}
+	var bookIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		bookIDs = append(bookIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	for _, bookID := range bookIDs {
+		tx, err := r.db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec("SELECT id FROM books WHERE id = $1 FOR UPDATE", bookID); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := recomputeBookAggregates(tx, bookID); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
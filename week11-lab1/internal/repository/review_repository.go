@@ -0,0 +1,18 @@
+package repository
+
+import "week11-lab1/internal/models"
+
+// ReviewRepository is the data access surface for book reviews.
+type ReviewRepository interface {
+	// Create inserts review and recomputes the parent book's Rating and
+	// ReviewsCount from the full set of reviews, in a single transaction.
+	Create(review *models.Review) error
+	ListByBook(bookID, limit, offset int) (reviews []models.Review, total int, err error)
+	// Delete removes a review and recomputes its parent book's aggregates,
+	// in a single transaction.
+	Delete(id int) error
+	// RecomputeAllAggregates recalculates Rating and ReviewsCount on every
+	// book from its reviews. Used to backfill and by the nightly
+	// -recompute-aggregates job.
+	RecomputeAllAggregates() error
+}
@@ -0,0 +1,180 @@
+// Package service holds business logic that sits between the handlers and
+// the repository: composing repository calls, applying rules that aren't
+// simple CRUD (metadata import/enrichment, featured/new/discounted
+// selection).
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/query"
+	"week11-lab1/internal/repository"
+)
+
+// ErrBookNotFound is returned when a book id doesn't exist.
+var ErrBookNotFound = errors.New("service: book not found")
+
+// uncategorizedCategory is the top-level category imported books are
+// bucketed into when metadata doesn't carry category information.
+const uncategorizedCategory = "Uncategorized"
+
+// BookService is the business-logic layer handlers depend on, built via
+// constructor injection so it can be tested against a mock
+// repository.BookRepository.
+type BookService struct {
+	repo       repository.BookRepository
+	categories repository.CategoryRepository
+	metadata   *query.Registry
+}
+
+func NewBookService(repo repository.BookRepository, categories repository.CategoryRepository, metadata *query.Registry) *BookService {
+	return &BookService{repo: repo, categories: categories, metadata: metadata}
+}
+
+func (s *BookService) List(params repository.ListParams) ([]models.Book, int, error) {
+	return s.repo.List(params)
+}
+
+func (s *BookService) Search(params repository.SearchParams) ([]models.Book, int, error) {
+	return s.repo.Search(params)
+}
+
+func (s *BookService) GetByID(id int) (*models.Book, error) {
+	book, err := s.repo.GetByID(id)
+	if err == sql.ErrNoRows {
+		return nil, ErrBookNotFound
+	}
+	return book, err
+}
+
+func (s *BookService) Create(book *models.Book) error {
+	return s.repo.Create(book)
+}
+
+func (s *BookService) Update(id int, book *models.Book) error {
+	err := s.repo.Update(id, book)
+	if err == sql.ErrNoRows {
+		return ErrBookNotFound
+	}
+	return err
+}
+
+func (s *BookService) Delete(id int) error {
+	err := s.repo.Delete(id)
+	if err == sql.ErrNoRows {
+		return ErrBookNotFound
+	}
+	return err
+}
+
+func (s *BookService) Featured() ([]models.Book, error) {
+	return s.repo.Featured()
+}
+
+func (s *BookService) New() ([]models.Book, error) {
+	return s.repo.New()
+}
+
+func (s *BookService) Discounted() ([]models.Book, error) {
+	return s.repo.Discounted()
+}
+
+// Import resolves metadata for isbn from the configured providers and
+// creates a book from it.
+func (s *BookService) Import(isbn string) (*models.Book, error) {
+	meta, err := s.metadata.LookupISBN(isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := s.bookFromMetadata(isbn, meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(&book); err != nil {
+		return nil, fmt.Errorf("service: create imported book: %w", err)
+	}
+	return &book, nil
+}
+
+// Enrich backfills missing fields on an existing book from external
+// metadata, without overwriting fields that already have a value.
+func (s *BookService) Enrich(id int) (*models.Book, error) {
+	book, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := s.metadata.LookupISBN(book.ISBN)
+	if err == query.ErrNotFound {
+		return book, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	applyMetadataFallback(book, meta)
+
+	if err := s.repo.UpdateMetadata(id, book); err != nil {
+		return nil, fmt.Errorf("service: update enriched book: %w", err)
+	}
+	return book, nil
+}
+
+func (s *BookService) bookFromMetadata(isbn string, meta *query.Book) (models.Book, error) {
+	categoryID, err := s.categories.GetOrCreateByName(uncategorizedCategory)
+	if err != nil {
+		return models.Book{}, fmt.Errorf("service: resolve default category: %w", err)
+	}
+
+	return models.Book{
+		Title:       meta.Title,
+		Author:      meta.Author,
+		ISBN:        isbn,
+		Year:        meta.Year,
+		CategoryID:  categoryID,
+		CoverImage:  meta.CoverImage,
+		Pages:       nullInt64(meta.Pages),
+		Language:    meta.Language,
+		Publisher:   meta.Publisher,
+		Description: meta.Description,
+	}, nil
+}
+
+// applyMetadataFallback fills zero-valued fields on book from meta,
+// leaving any field that already has a non-empty value untouched.
+func applyMetadataFallback(book *models.Book, meta *query.Book) {
+	if book.Title == "" {
+		book.Title = meta.Title
+	}
+	if book.Author == "" {
+		book.Author = meta.Author
+	}
+	if book.CoverImage == "" {
+		book.CoverImage = meta.CoverImage
+	}
+	if !book.Pages.Valid && meta.Pages > 0 {
+		book.Pages = nullInt64(meta.Pages)
+	}
+	if book.Language == "" {
+		book.Language = meta.Language
+	}
+	if book.Publisher == "" {
+		book.Publisher = meta.Publisher
+	}
+	if book.Description == "" {
+		book.Description = meta.Description
+	}
+	if book.Year == 0 {
+		book.Year = meta.Year
+	}
+}
+
+func nullInt64(v int) models.NullInt64 {
+	if v <= 0 {
+		return models.NullInt64{}
+	}
+	return models.NullInt64{NullInt64: sql.NullInt64{Int64: int64(v), Valid: true}}
+}
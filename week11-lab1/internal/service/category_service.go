@@ -0,0 +1,87 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/repository"
+)
+
+// ErrCategoryNotFound is returned when a category id doesn't exist.
+var ErrCategoryNotFound = errors.New("service: category not found")
+
+// CategoryService is the business-logic layer for categories, built via
+// constructor injection so it can be tested against a mock
+// repository.CategoryRepository.
+type CategoryService struct {
+	repo repository.CategoryRepository
+}
+
+func NewCategoryService(repo repository.CategoryRepository) *CategoryService {
+	return &CategoryService{repo: repo}
+}
+
+// Flat returns every category as a flat list, optionally restricted to the
+// direct children of parentID.
+func (s *CategoryService) Flat(parentID *int) ([]models.Category, error) {
+	all, err := s.repo.All()
+	if err != nil {
+		return nil, err
+	}
+	if parentID == nil {
+		return all, nil
+	}
+
+	children := []models.Category{}
+	for _, c := range all {
+		if c.ParentID != nil && *c.ParentID == *parentID {
+			children = append(children, c)
+		}
+	}
+	return children, nil
+}
+
+// Tree loads every category once and assembles it into a nested structure
+// in-memory, rooted at parentID (nil for the top level).
+func (s *CategoryService) Tree(parentID *int) ([]*models.Category, error) {
+	all, err := s.repo.All()
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*models.Category, len(all))
+	for i := range all {
+		byID[all[i].ID] = &all[i]
+	}
+
+	roots := []*models.Category{}
+	for i := range all {
+		c := byID[all[i].ID]
+		if c.ParentID == nil {
+			if parentID == nil {
+				roots = append(roots, c)
+			}
+			continue
+		}
+
+		if parent, ok := byID[*c.ParentID]; ok {
+			parent.Children = append(parent.Children, c)
+		}
+		if parentID != nil && *c.ParentID == *parentID {
+			roots = append(roots, c)
+		}
+	}
+
+	return roots, nil
+}
+
+// BooksInCategory returns books in category id and all of its descendants.
+func (s *CategoryService) BooksInCategory(id int, params repository.ListParams) ([]models.Book, int, error) {
+	if _, err := s.repo.GetByID(id); err == sql.ErrNoRows {
+		return nil, 0, ErrCategoryNotFound
+	} else if err != nil {
+		return nil, 0, err
+	}
+	return s.repo.BooksInCategory(id, params)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/repository"
+)
+
+type mockCategoryRepository struct {
+	categories []models.Category
+	byID       map[int]models.Category
+}
+
+func (m *mockCategoryRepository) All() ([]models.Category, error) {
+	return m.categories, nil
+}
+
+func (m *mockCategoryRepository) GetByID(id int) (*models.Category, error) {
+	c, ok := m.byID[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &c, nil
+}
+
+func (m *mockCategoryRepository) BooksInCategory(id int, params repository.ListParams) ([]models.Book, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockCategoryRepository) GetOrCreateByName(name string) (int, error) {
+	return 0, nil
+}
+
+func newMockCategoryRepository(categories []models.Category) *mockCategoryRepository {
+	byID := make(map[int]models.Category, len(categories))
+	for _, c := range categories {
+		byID[c.ID] = c
+	}
+	return &mockCategoryRepository{categories: categories, byID: byID}
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestCategoryServiceTree(t *testing.T) {
+	repo := newMockCategoryRepository([]models.Category{
+		{ID: 1, Name: "Programming"},
+		{ID: 2, Name: "Go", ParentID: intPtr(1)},
+		{ID: 3, Name: "Databases"},
+	})
+	svc := NewCategoryService(repo)
+
+	roots, err := svc.Tree(nil)
+	if err != nil {
+		t.Fatalf("Tree(nil) error: %v", err)
+	}
+	if len(roots) != 2 {
+		t.Fatalf("Tree(nil) returned %d roots, want 2", len(roots))
+	}
+
+	var programming *models.Category
+	for _, r := range roots {
+		if r.ID == 1 {
+			programming = r
+		}
+	}
+	if programming == nil {
+		t.Fatal("Tree(nil) missing category 1 (Programming)")
+	}
+	if len(programming.Children) != 1 || programming.Children[0].ID != 2 {
+		t.Fatalf("Programming.Children = %+v, want a single child with ID 2", programming.Children)
+	}
+
+	children, err := svc.Tree(intPtr(1))
+	if err != nil {
+		t.Fatalf("Tree(1) error: %v", err)
+	}
+	if len(children) != 1 || children[0].ID != 2 {
+		t.Fatalf("Tree(1) = %+v, want a single category with ID 2", children)
+	}
+}
+
+func TestCategoryServiceBooksInCategoryNotFound(t *testing.T) {
+	repo := newMockCategoryRepository(nil)
+	svc := NewCategoryService(repo)
+
+	_, _, err := svc.BooksInCategory(99, repository.ListParams{})
+	if err != ErrCategoryNotFound {
+		t.Fatalf("BooksInCategory(99) error = %v, want ErrCategoryNotFound", err)
+	}
+}
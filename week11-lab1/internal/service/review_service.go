@@ -0,0 +1,55 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+
+	"week11-lab1/internal/models"
+	"week11-lab1/internal/repository"
+)
+
+// ErrReviewNotFound is returned when a review id doesn't exist.
+var ErrReviewNotFound = errors.New("service: review not found")
+
+// ReviewService is the business-logic layer for book reviews, built via
+// constructor injection so it can be tested against a mock
+// repository.ReviewRepository.
+type ReviewService struct {
+	reviews repository.ReviewRepository
+	books   repository.BookRepository
+}
+
+func NewReviewService(reviews repository.ReviewRepository, books repository.BookRepository) *ReviewService {
+	return &ReviewService{reviews: reviews, books: books}
+}
+
+// Create adds a review for bookID, failing with ErrBookNotFound if the
+// book doesn't exist.
+func (s *ReviewService) Create(bookID int, review *models.Review) error {
+	if _, err := s.books.GetByID(bookID); err == sql.ErrNoRows {
+		return ErrBookNotFound
+	} else if err != nil {
+		return err
+	}
+
+	review.BookID = bookID
+	return s.reviews.Create(review)
+}
+
+func (s *ReviewService) ListByBook(bookID, limit, offset int) ([]models.Review, int, error) {
+	return s.reviews.ListByBook(bookID, limit, offset)
+}
+
+func (s *ReviewService) Delete(id int) error {
+	err := s.reviews.Delete(id)
+	if err == sql.ErrNoRows {
+		return ErrReviewNotFound
+	}
+	return err
+}
+
+// RecomputeAllAggregates recalculates every book's rating/reviews_count
+// from its reviews. Intended for the -recompute-aggregates CLI flag.
+func (s *ReviewService) RecomputeAllAggregates() error {
+	return s.reviews.RecomputeAllAggregates()
+}